@@ -0,0 +1,82 @@
+// Command consumer reads messages published by cmd/producer, extracting the
+// trace context from the Kafka record headers so each message is processed
+// as part of the producer's trace.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/Shopify/sarama/otelsarama"
+	"go.opentelemetry.io/otel"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/anilmisirlioglu/go-kafka-tracing-example/internal/tracing"
+)
+
+const topic = "sample-topic"
+
+func main() {
+	shutdown, err := tracing.Init(context.Background(), "kafka-consumer")
+	if err != nil {
+		log.Fatalf("tracing.Init: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			log.Printf("failed to shut down tracer: %v", err)
+		}
+	}()
+	tracer := otel.Tracer("company.com/trace/kafka-consumer")
+
+	consumer, err := sarama.NewConsumer(brokers(), sarama.NewConfig())
+	if err != nil {
+		log.Fatalf("sarama.NewConsumer: %v", err)
+	}
+	defer func() { _ = consumer.Close() }()
+
+	partitionConsumer, err := consumer.ConsumePartition(topic, 0, sarama.OffsetNewest)
+	if err != nil {
+		log.Fatalf("consumer.ConsumePartition: %v", err)
+	}
+	defer func() { _ = partitionConsumer.Close() }()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+
+	log.Println("waiting for messages")
+	for {
+		select {
+		case msg := <-partitionConsumer.Messages():
+			process(tracer, msg)
+		case <-stop:
+			log.Println("shutting down consumer")
+			return
+		}
+	}
+}
+
+func process(tracer oteltrace.Tracer, msg *sarama.ConsumerMessage) {
+	carrier := otelsarama.NewConsumerMessageCarrier(msg)
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	_, span := tracer.Start(ctx, "process message", oteltrace.WithSpanKind(oteltrace.SpanKindConsumer))
+	defer span.End()
+
+	log.Printf("partition=%d offset=%d value=%s", msg.Partition, msg.Offset, msg.Value)
+}
+
+func brokers() []string {
+	list := os.Getenv("KAFKA_BROKERS")
+	if list == "" {
+		list = "localhost:9092"
+	}
+	return strings.Split(list, ",")
+}
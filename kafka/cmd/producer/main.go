@@ -0,0 +1,111 @@
+// Command producer serves /run, which publishes a message to Kafka on every
+// call. A "produce message" span is started under the /run request's span
+// and its context is injected into the record headers via otelsarama's
+// carrier so the consumer can join the same trace.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/Shopify/sarama/otelsarama"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/anilmisirlioglu/go-kafka-tracing-example/internal/tracing"
+)
+
+const topic = "sample-topic"
+
+var tracer trace.Tracer
+
+func main() {
+	shutdown, err := tracing.Init(context.Background(), "kafka-producer")
+	if err != nil {
+		log.Fatalf("tracing.Init: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			log.Printf("failed to shut down tracer: %v", err)
+		}
+	}()
+	tracer = otel.Tracer("company.com/trace/kafka-producer")
+
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(brokers(), saramaCfg)
+	if err != nil {
+		log.Fatalf("sarama.NewSyncProducer: %v", err)
+	}
+	defer func() { _ = producer.Close() }()
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+		log.Printf("defaulting to port %s", port)
+	}
+
+	http.HandleFunc("/run", handler(producer))
+
+	log.Printf("server starting at: %s", port)
+	log.Fatal(http.ListenAndServe(":"+port, nil))
+}
+
+func handler(producer sarama.SyncProducer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracer.Start(r.Context(), "/run")
+		defer span.End()
+
+		payload, err := json.Marshal(map[string]string{
+			"message": "hello from producer",
+			"sentAt":  time.Now().Format(time.RFC3339),
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		msg := &sarama.ProducerMessage{
+			Topic: topic,
+			Value: sarama.ByteEncoder(payload),
+		}
+
+		// otelsarama.WrapSyncProducer can't be used here: sarama.SyncProducer's
+		// SendMessage takes no context, so its span would always root from
+		// context.Background() instead of chaining off /run. Start the produce
+		// span ourselves and inject it into the record headers directly.
+		produceCtx, produceSpan := tracer.Start(ctx, "produce message", trace.WithSpanKind(trace.SpanKindProducer))
+		otel.GetTextMapPropagator().Inject(produceCtx, otelsarama.NewProducerMessageCarrier(msg))
+
+		partition, offset, err := producer.SendMessage(msg)
+		produceSpan.End()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		_, _ = w.Write([]byte(
+			"published to partition " + strconv.FormatInt(int64(partition), 10) +
+				" at offset " + strconv.FormatInt(offset, 10)))
+	}
+}
+
+func brokers() []string {
+	list := os.Getenv("KAFKA_BROKERS")
+	if list == "" {
+		list = "localhost:9092"
+	}
+	return strings.Split(list, ",")
+}
@@ -0,0 +1,46 @@
+// Package tracing sets up a Jaeger-backed TracerProvider shared by the
+// producer and consumer binaries in this example.
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Init points a TracerProvider at the Jaeger collector named by the
+// JAEGER_ENDPOINT env var (default http://localhost:14268/api/traces),
+// installs it as the global provider, and returns a shutdown func the
+// caller must defer.
+func Init(ctx context.Context, serviceName string) (shutdown func(context.Context) error, err error) {
+	endpoint := os.Getenv("JAEGER_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "http://localhost:14268/api/traces"
+	}
+
+	exporter, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(endpoint)))
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return tp.Shutdown, nil
+}
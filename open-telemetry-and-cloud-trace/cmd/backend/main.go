@@ -0,0 +1,78 @@
+// Command backend serves repository metadata over HTTP, fetching it from
+// GitHub and recording a simulated cache lookup span in front of it. It is
+// called by cmd/frontend so the two processes show up as one distributed
+// trace.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/anilmisirlioglu/go-github-examples/internal/github"
+	"github.com/anilmisirlioglu/go-github-examples/internal/httpserver"
+	"github.com/anilmisirlioglu/go-github-examples/internal/tracing"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+var (
+	httpClient = &http.Client{
+		Timeout:   5 * time.Second,
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}
+	tracer trace.Tracer
+)
+
+func main() {
+	shutdown, err := tracing.Init(context.Background(), tracing.ConfigFromEnv("backend-service", "1.0.0", "backend-1"))
+	if err != nil {
+		log.Fatalf("tracing.Init: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			log.Printf("failed to shut down tracer: %v", err)
+		}
+	}()
+
+	tracer = otel.Tracer("company.com/trace/backend")
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8081"
+		log.Printf("defaulting to port %s", port)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/repo", tracing.Middleware(otelhttp.NewHandler(http.HandlerFunc(handler), "/repo")))
+	httpserver.Serve(":"+port, mux, shutdownTimeout)
+}
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	span := trace.SpanFromContext(ctx)
+
+	cacheCtx, cacheSpan := tracer.Start(ctx, "cache lookup")
+	cacheSpan.AddEvent("cache miss")
+	cacheSpan.End()
+
+	repo, err := github.Fetch(cacheCtx, tracer, httpClient, "https://api.github.com/repos/golang/go")
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		span.RecordError(err)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(repo)
+}
@@ -0,0 +1,124 @@
+// Command frontend serves /run, which calls cmd/backend over HTTP with the
+// trace context propagated via otelhttp, and renders the repository it gets
+// back. Run both binaries to see the combined end-to-end trace.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/anilmisirlioglu/go-github-examples/internal/github"
+	"github.com/anilmisirlioglu/go-github-examples/internal/httpserver"
+	"github.com/anilmisirlioglu/go-github-examples/internal/tracing"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+var (
+	httpClient = &http.Client{
+		Timeout:   5 * time.Second,
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}
+	tracer trace.Tracer
+)
+
+func main() {
+	shutdown, err := tracing.Init(context.Background(), tracing.ConfigFromEnv("frontend-service", "1.0.0", "frontend-1"))
+	if err != nil {
+		log.Fatalf("tracing.Init: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			log.Printf("failed to shut down tracer: %v", err)
+		}
+	}()
+
+	tracer = otel.Tracer("company.com/trace/frontend")
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+		log.Printf("defaulting to port %s", port)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/run", tracing.Middleware(otelhttp.NewHandler(http.HandlerFunc(handler), "/run")))
+	httpserver.Serve(":"+port, mux, shutdownTimeout)
+}
+
+func backendAddr() string {
+	addr := os.Getenv("BACKEND_ADDR")
+	if addr == "" {
+		addr = "http://localhost:8081"
+	}
+	return addr
+}
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("handling request")
+
+	repo, err := fetchRepo(ctx)
+
+	_, wSpan := tracer.Start(ctx, "write")
+	defer wSpan.End()
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintf(io.MultiWriter(os.Stderr, w), "error: %v\n", err)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("golang.go.repo.name", repo.FullName),
+		attribute.Int("golang.go.repo.id", repo.Id),
+		attribute.Int("golang.go.repo.stars", repo.StargazersCount),
+	)
+
+	_, _ = fmt.Fprintf(w,
+		"===== %s =====\nRepository: %s (ID: %d)\nStar Count: %d\nFork Count: %d\nURL: https://github.com/%s",
+		repo.FullName, repo.Name, repo.Id, repo.StargazersCount, repo.Forks, repo.FullName,
+	)
+}
+
+func fetchRepo(ctx context.Context) (*github.Repository, error) {
+	subCtx, span := tracer.Start(ctx, "call backend")
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(subCtx, http.MethodGet, backendAddr()+"/repo", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("backend: %s: %s", resp.Status, body)
+	}
+
+	repo := new(github.Repository)
+	if err := json.NewDecoder(resp.Body).Decode(repo); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
@@ -0,0 +1,62 @@
+// Package github fetches repository metadata from the GitHub REST API.
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Repository is the subset of the GitHub "get a repository" response this
+// sample cares about.
+type Repository struct {
+	Id              int    `json:"id"`
+	StargazersCount int    `json:"stargazers_count"`
+	Forks           int    `json:"forks"`
+	Name            string `json:"name"`
+	FullName        string `json:"full_name"`
+}
+
+// Fetch retrieves url with client and decodes it as a Repository, recording
+// "fetch json" and "parse json" child spans on tracer.
+func Fetch(ctx context.Context, tracer trace.Tracer, client *http.Client, url string) (*Repository, error) {
+	subCtx, span := tracer.Start(ctx, "fetch json")
+	timeoutCtx, cancel := context.WithTimeout(subCtx, 3*time.Second)
+	defer cancel()
+	span.AddEvent("fetching repo info from github")
+
+	req, err := http.NewRequestWithContext(timeoutCtx, http.MethodGet, url, nil)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+	r, err := client.Do(req)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "github.resp.status.code",
+		Value: attribute.IntValue(r.StatusCode),
+	})
+	span.End()
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(r.Body)
+
+	_, span = tracer.Start(ctx, "parse json")
+	defer span.End()
+
+	repo := new(Repository)
+	if err := json.NewDecoder(r.Body).Decode(repo); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
@@ -0,0 +1,37 @@
+// Package httpserver holds the graceful-shutdown boilerplate shared by the
+// cmd/frontend and cmd/backend binaries.
+package httpserver
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// Serve starts an HTTP server on addr and blocks until SIGINT/SIGTERM is
+// received, then shuts it down within shutdownTimeout.
+func Serve(addr string, handler http.Handler, shutdownTimeout time.Duration) {
+	srv := &http.Server{Addr: addr, Handler: handler}
+
+	go func() {
+		log.Printf("server starting at: %s", addr)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("http.ListenAndServe: %v", err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	log.Println("shutting down server")
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("http server shutdown: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+// Package tracing provides the exporter and tracer provider setup shared by
+// the samples in this module.
+package tracing
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config controls which exporter backend is instantiated and how it is
+// configured. It is normally populated from the environment via
+// ConfigFromEnv, but can be constructed directly for tests.
+type Config struct {
+	// Exporter selects the backend: "stackdriver", "otlp-grpc", "otlp-http",
+	// "jaeger", or "stdout". Defaults to "stackdriver" for backwards
+	// compatibility with the original sample.
+	Exporter string
+
+	// ServiceName, ServiceVersion and InstanceID populate the OTel resource
+	// attributes attached to every span.
+	ServiceName    string
+	ServiceVersion string
+	InstanceID     string
+
+	// OTLPEndpoint is the collector endpoint used by both otlp-grpc and
+	// otlp-http, e.g. "localhost:4317" or "localhost:4318".
+	OTLPEndpoint string
+	// OTLPInsecure disables TLS when talking to the collector.
+	OTLPInsecure bool
+	// OTLPHeaders are sent with every export request, e.g. for auth.
+	OTLPHeaders map[string]string
+	// OTLPCompression selects the wire compression, e.g. "gzip".
+	OTLPCompression string
+	// OTLPTimeout bounds a single export call.
+	OTLPTimeout time.Duration
+
+	// JaegerEndpoint is the collector HTTP endpoint, e.g.
+	// "http://localhost:14268/api/traces".
+	JaegerEndpoint string
+
+	// SamplerType selects the sampling strategy: "always_on", "always_off",
+	// "traceidratio", "parentbased_traceidratio", or the non-standard
+	// "rate_limited". Defaults to "parentbased_always_on".
+	SamplerType string
+	// SamplerArg is the ratio (0-1) for the traceidratio samplers, or the
+	// per-second rate for "rate_limited".
+	SamplerArg string
+}
+
+// ConfigFromEnv builds a Config from the OTEL_EXPORTER family of environment
+// variables, falling back to the given defaults for the resource attributes.
+func ConfigFromEnv(serviceName, serviceVersion, instanceID string) Config {
+	cfg := Config{
+		Exporter:        strings.ToLower(os.Getenv("OTEL_EXPORTER")),
+		ServiceName:     serviceName,
+		ServiceVersion:  serviceVersion,
+		InstanceID:      instanceID,
+		OTLPEndpoint:    os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
+		OTLPInsecure:    os.Getenv("OTEL_EXPORTER_OTLP_INSECURE") == "true",
+		OTLPHeaders:     parseHeaders(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS")),
+		OTLPCompression: os.Getenv("OTEL_EXPORTER_OTLP_COMPRESSION"),
+		OTLPTimeout:     parseDuration(os.Getenv("OTEL_EXPORTER_OTLP_TIMEOUT"), 10*time.Second),
+		JaegerEndpoint:  os.Getenv("OTEL_EXPORTER_JAEGER_ENDPOINT"),
+		SamplerType:     strings.ToLower(os.Getenv("OTEL_TRACES_SAMPLER")),
+		SamplerArg:      os.Getenv("OTEL_TRACES_SAMPLER_ARG"),
+	}
+	if cfg.Exporter == "" {
+		cfg.Exporter = "stackdriver"
+	}
+	return cfg
+}
+
+// parseHeaders parses a comma-separated list of key=value pairs, the format
+// used by OTEL_EXPORTER_OTLP_HEADERS.
+func parseHeaders(s string) map[string]string {
+	if s == "" {
+		return nil
+	}
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		idx := strings.Index(pair, "=")
+		if idx < 0 {
+			continue
+		}
+		k, v := pair[:idx], pair[idx+1:]
+		headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return headers
+}
+
+func parseDuration(s string, fallback time.Duration) time.Duration {
+	if s == "" {
+		return fallback
+	}
+	if ms, err := strconv.Atoi(s); err == nil {
+		return time.Duration(ms) * time.Millisecond
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return d
+	}
+	return fallback
+}
@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	texporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/trace"
+	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/credentials"
+)
+
+// NewExporter builds the sdktrace.SpanExporter selected by cfg.Exporter. It
+// is the single place that knows how to translate Config into the
+// exporter-specific client options for each supported backend.
+func NewExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case "stackdriver", "":
+		return texporter.New(texporter.WithTraceClientOptions([]option.ClientOption{
+			option.WithTelemetryDisabled(),
+		}))
+	case "otlp-grpc":
+		return newOTLPGRPCExporter(ctx, cfg)
+	case "otlp-http":
+		return newOTLPHTTPExporter(ctx, cfg)
+	case "jaeger":
+		return newJaegerExporter(cfg)
+	case "stdout":
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("tracing: unknown exporter %q", cfg.Exporter)
+	}
+}
+
+func newOTLPGRPCExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint),
+		otlptracegrpc.WithTimeout(cfg.OTLPTimeout),
+	}
+	if cfg.OTLPInsecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	} else {
+		opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, "")))
+	}
+	if len(cfg.OTLPHeaders) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(cfg.OTLPHeaders))
+	}
+	if cfg.OTLPCompression != "" {
+		opts = append(opts, otlptracegrpc.WithCompressor(cfg.OTLPCompression))
+	}
+	return otlptrace.New(ctx, otlptracegrpc.NewClient(opts...))
+}
+
+func newOTLPHTTPExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(cfg.OTLPEndpoint),
+		otlptracehttp.WithTimeout(cfg.OTLPTimeout),
+	}
+	if cfg.OTLPInsecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	if len(cfg.OTLPHeaders) > 0 {
+		opts = append(opts, otlptracehttp.WithHeaders(cfg.OTLPHeaders))
+	}
+	if cfg.OTLPCompression == "gzip" {
+		opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+	}
+	return otlptrace.New(ctx, otlptracehttp.NewClient(opts...))
+}
+
+func newJaegerExporter(cfg Config) (sdktrace.SpanExporter, error) {
+	endpoint := cfg.JaegerEndpoint
+	if endpoint == "" {
+		endpoint = "http://localhost:14268/api/traces"
+	}
+	return jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(endpoint)))
+}
@@ -0,0 +1,134 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// jaegerDebugIDHeader mirrors Jaeger's client libraries: any request
+// carrying it is always sampled, regardless of the configured strategy, so a
+// developer can reliably capture one specific trace.
+const jaegerDebugIDHeader = "jaeger-debug-id"
+
+type debugIDKey struct{}
+
+// Middleware forces sampling for requests that carry the jaeger-debug-id
+// header by stashing it in the request context, where BuildSampler's
+// wrapping sampler picks it up. It must run before the span for the request
+// is started, so it must be the outer wrapper around otelhttp.NewHandler.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id := r.Header.Get(jaegerDebugIDHeader); id != "" {
+			r = r.WithContext(context.WithValue(r.Context(), debugIDKey{}, id))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// BuildSampler selects the sdktrace.Sampler described by cfg.SamplerType and
+// cfg.SamplerArg, following the OTEL_TRACES_SAMPLER/OTEL_TRACES_SAMPLER_ARG
+// env vars from the OTel spec, and wraps it so a request flagged by
+// Middleware is always sampled.
+func BuildSampler(cfg Config) sdktrace.Sampler {
+	return &debugIDSampler{fallback: samplerFromConfig(cfg)}
+}
+
+func samplerFromConfig(cfg Config) sdktrace.Sampler {
+	switch cfg.SamplerType {
+	case "always_on":
+		return sdktrace.AlwaysSample()
+	case "always_off":
+		return sdktrace.NeverSample()
+	case "traceidratio":
+		return sdktrace.TraceIDRatioBased(floatArg(cfg.SamplerArg, 1))
+	case "parentbased_traceidratio":
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(floatArg(cfg.SamplerArg, 1)))
+	case "rate_limited":
+		return sdktrace.ParentBased(newRateLimitedSampler(floatArg(cfg.SamplerArg, 1)))
+	default:
+		// "parentbased_always_on" is the OTel spec default.
+		return sdktrace.ParentBased(sdktrace.AlwaysSample())
+	}
+}
+
+func floatArg(arg string, fallback float64) float64 {
+	if arg == "" {
+		return fallback
+	}
+	v, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+// debugIDSampler defers to fallback, except for spans whose parent context
+// was flagged by Middleware, which are always recorded and sampled.
+type debugIDSampler struct {
+	fallback sdktrace.Sampler
+}
+
+func (s *debugIDSampler) ShouldSample(params sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	if id, ok := params.ParentContext.Value(debugIDKey{}).(string); ok && id != "" {
+		return sdktrace.SamplingResult{Decision: sdktrace.RecordAndSample}
+	}
+	return s.fallback.ShouldSample(params)
+}
+
+func (s *debugIDSampler) Description() string {
+	return "DebugIDSampler{" + s.fallback.Description() + "}"
+}
+
+// rateLimitedSampler is a token-bucket sampler allowing at most ratePerSecond
+// sampled traces per second, refilling continuously between calls.
+type rateLimitedSampler struct {
+	mu         sync.Mutex
+	ratePerSec float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newRateLimitedSampler(ratePerSecond float64) *rateLimitedSampler {
+	if ratePerSecond <= 0 {
+		ratePerSecond = 1
+	}
+	return &rateLimitedSampler{
+		ratePerSec: ratePerSecond,
+		tokens:     ratePerSecond,
+		lastRefill: time.Now(),
+	}
+}
+
+func (s *rateLimitedSampler) ShouldSample(sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	if s.allow() {
+		return sdktrace.SamplingResult{Decision: sdktrace.RecordAndSample}
+	}
+	return sdktrace.SamplingResult{Decision: sdktrace.Drop}
+}
+
+func (s *rateLimitedSampler) allow() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.tokens += now.Sub(s.lastRefill).Seconds() * s.ratePerSec
+	if s.tokens > s.ratePerSec {
+		s.tokens = s.ratePerSec
+	}
+	s.lastRefill = now
+
+	if s.tokens < 1 {
+		return false
+	}
+	s.tokens--
+	return true
+}
+
+func (s *rateLimitedSampler) Description() string {
+	return "RateLimitedSampler"
+}
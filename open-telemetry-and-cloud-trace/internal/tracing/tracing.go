@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Init builds the exporter and TracerProvider described by cfg, installs the
+// provider as the global one via otel.SetTracerProvider, and returns a
+// shutdown func that flushes and closes the exporter. Callers must defer the
+// returned shutdown in main so spans are flushed on exit; Init itself never
+// blocks on flushing.
+func Init(ctx context.Context, cfg Config) (shutdown func(context.Context) error, err error) {
+	exporter, err := NewExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter,
+			sdktrace.WithBatchTimeout(time.Second),
+			sdktrace.WithMaxExportBatchSize(16)),
+		sdktrace.WithSampler(BuildSampler(cfg)),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", cfg.ServiceName),
+			attribute.String("service.version", cfg.ServiceVersion),
+			attribute.String("instance.id", cfg.InstanceID),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return tp.Shutdown, nil
+}